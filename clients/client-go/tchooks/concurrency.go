@@ -0,0 +1,49 @@
+package tchooks
+
+import "fmt"
+
+// Recognized values for HookCreationRequest.ConcurrencyPolicy and
+// HookDefinition.ConcurrencyPolicy.
+const (
+	// ConcurrencyPolicyAllow lets a new fire proceed even if a task created
+	// by a previous fire of the same hook is still running. This is the
+	// default, and matches the hooks service's historical behavior.
+	ConcurrencyPolicyAllow = "Allow"
+
+	// ConcurrencyPolicyForbid skips creating a new task if a task created by
+	// a previous fire of the same hook is still running.
+	ConcurrencyPolicyForbid = "Forbid"
+
+	// ConcurrencyPolicyReplace cancels the outstanding task created by a
+	// previous fire of the same hook, via the Queue, and creates a new one.
+	ConcurrencyPolicyReplace = "Replace"
+)
+
+// ValidateConcurrencyPolicy checks that policy is a value the hooks service
+// understands, treating the empty string as the default (ConcurrencyPolicyAllow).
+func ValidateConcurrencyPolicy(policy string) error {
+	switch policy {
+	case "", ConcurrencyPolicyAllow, ConcurrencyPolicyForbid, ConcurrencyPolicyReplace:
+		return nil
+	default:
+		return fmt.Errorf("tchooks: invalid concurrencyPolicy %q", policy)
+	}
+}
+
+// Validate checks the concurrency-related fields of a HookCreationRequest
+// for internal consistency before it is submitted to the hooks service.
+func (hcr *HookCreationRequest) Validate() error {
+	if err := ValidateConcurrencyPolicy(hcr.ConcurrencyPolicy); err != nil {
+		return err
+	}
+	if hcr.StartingDeadlineSeconds < 0 {
+		return fmt.Errorf("tchooks: startingDeadlineSeconds must not be negative, got %d", hcr.StartingDeadlineSeconds)
+	}
+	if hcr.SuccessfulJobsHistoryLimit < 0 {
+		return fmt.Errorf("tchooks: successfulJobsHistoryLimit must not be negative, got %d", hcr.SuccessfulJobsHistoryLimit)
+	}
+	if hcr.FailedJobsHistoryLimit < 0 {
+		return fmt.Errorf("tchooks: failedJobsHistoryLimit must not be negative, got %d", hcr.FailedJobsHistoryLimit)
+	}
+	return nil
+}
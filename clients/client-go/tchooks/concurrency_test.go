@@ -0,0 +1,43 @@
+package tchooks
+
+import "testing"
+
+func TestValidateConcurrencyPolicy(t *testing.T) {
+	valid := []string{"", ConcurrencyPolicyAllow, ConcurrencyPolicyForbid, ConcurrencyPolicyReplace}
+	for _, policy := range valid {
+		if err := ValidateConcurrencyPolicy(policy); err != nil {
+			t.Errorf("ValidateConcurrencyPolicy(%q) = %v, want nil", policy, err)
+		}
+	}
+
+	invalid := []string{"allow", "FORBID", "bogus"}
+	for _, policy := range invalid {
+		if err := ValidateConcurrencyPolicy(policy); err == nil {
+			t.Errorf("ValidateConcurrencyPolicy(%q) = nil, want an error", policy)
+		}
+	}
+}
+
+func TestHookCreationRequestValidate(t *testing.T) {
+	valid := HookCreationRequest{
+		ConcurrencyPolicy:          ConcurrencyPolicyReplace,
+		StartingDeadlineSeconds:    60,
+		SuccessfulJobsHistoryLimit: 10,
+		FailedJobsHistoryLimit:     10,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+
+	cases := []HookCreationRequest{
+		{ConcurrencyPolicy: "bogus"},
+		{StartingDeadlineSeconds: -1},
+		{SuccessfulJobsHistoryLimit: -1},
+		{FailedJobsHistoryLimit: -1},
+	}
+	for _, hcr := range cases {
+		if err := hcr.Validate(); err == nil {
+			t.Errorf("Validate() on %+v = nil, want an error", hcr)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package tchooks
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	tcclient "github.com/taskcluster/taskcluster/clients/client-go/v23"
+)
+
+// ListHookRuns returns a page of this hook's run history matching query,
+// following the same ContinuationToken/Limit pagination convention as the
+// rest of Taskcluster's APIs.
+func (hooks *Hooks) ListHookRuns(hookGroupID, hookID string, query *HookRunsQuery) (*HookRunsPage, error) {
+	cd := tcclient.Client(*hooks)
+	responseObject, _, err := (&cd).APICall(
+		nil,
+		"GET",
+		"/hooks/"+url.QueryEscape(hookGroupID)+"/"+url.QueryEscape(hookID)+"/runs",
+		new(HookRunsPage),
+		query.Values(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return responseObject.(*HookRunsPage), nil
+}
+
+// Values encodes q as the query-string parameters expected by the hooks
+// service's ListHookRuns endpoint.
+func (q *HookRunsQuery) Values() url.Values {
+	v := url.Values{}
+	if q == nil {
+		return v
+	}
+	if q.ContinuationToken != "" {
+		v.Set("continuationToken", q.ContinuationToken)
+	}
+	if q.Limit != 0 {
+		v.Set("limit", strconv.FormatInt(q.Limit, 10))
+	}
+	if q.FiredBy != "" {
+		v.Set("firedBy", q.FiredBy)
+	}
+	if q.Result != "" {
+		v.Set("result", q.Result)
+	}
+	if !time.Time(q.Since).IsZero() {
+		v.Set("since", q.Since.String())
+	}
+	if !time.Time(q.Until).IsZero() {
+		v.Set("until", q.Until.String())
+	}
+	return v
+}
@@ -0,0 +1,45 @@
+package tchooks
+
+import (
+	"testing"
+	"time"
+
+	tcclient "github.com/taskcluster/taskcluster/clients/client-go/v23"
+)
+
+func TestHookRunsQueryValuesEmpty(t *testing.T) {
+	q := &HookRunsQuery{}
+	if got := q.Values(); len(got) != 0 {
+		t.Errorf("Values() = %v, want empty", got)
+	}
+}
+
+func TestHookRunsQueryValues(t *testing.T) {
+	since := tcclient.Time(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	q := &HookRunsQuery{
+		ContinuationToken: "abc",
+		Limit:             50,
+		FiredBy:           "schedule",
+		Result:            "success",
+		Since:             since,
+	}
+	v := q.Values()
+	if v.Get("continuationToken") != "abc" {
+		t.Errorf("continuationToken = %q, want %q", v.Get("continuationToken"), "abc")
+	}
+	if v.Get("limit") != "50" {
+		t.Errorf("limit = %q, want %q", v.Get("limit"), "50")
+	}
+	if v.Get("firedBy") != "schedule" {
+		t.Errorf("firedBy = %q, want %q", v.Get("firedBy"), "schedule")
+	}
+	if v.Get("result") != "success" {
+		t.Errorf("result = %q, want %q", v.Get("result"), "success")
+	}
+	if v.Get("since") == "" {
+		t.Errorf("since should be set when HookRunsQuery.Since is non-zero")
+	}
+	if v.Get("until") != "" {
+		t.Errorf("until = %q, want empty since HookRunsQuery.Until was not set", v.Get("until"))
+	}
+}
@@ -0,0 +1,111 @@
+package tchooks
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// lastFireTag is used to peek at the `result` discriminator of a LastFire
+// value before decoding it into its concrete type.
+type lastFireTag struct {
+	Result string `json:"result"`
+}
+
+// DecodeLastFire decodes HookStatusResponse.LastFire into its concrete type,
+// one of *SuccessfulFire, *FailedFire, or *NoFire, based on its `result`
+// field. It returns an error if LastFire is empty or its `result` is not
+// one of the known values, sparing callers from re-implementing this
+// discriminator logic themselves.
+func (hsr *HookStatusResponse) DecodeLastFire() (interface{}, error) {
+	if len(hsr.LastFire) == 0 {
+		return nil, fmt.Errorf("tchooks: lastFire is not present on this HookStatusResponse")
+	}
+
+	var tag lastFireTag
+	if err := json.Unmarshal(hsr.LastFire, &tag); err != nil {
+		return nil, fmt.Errorf("tchooks: could not determine lastFire result type: %w", err)
+	}
+
+	switch tag.Result {
+	case "success":
+		var fire SuccessfulFire
+		if err := json.Unmarshal(hsr.LastFire, &fire); err != nil {
+			return nil, fmt.Errorf("tchooks: could not decode lastFire as SuccessfulFire: %w", err)
+		}
+		return &fire, nil
+	case "error":
+		var fire FailedFire
+		if err := json.Unmarshal(hsr.LastFire, &fire); err != nil {
+			return nil, fmt.Errorf("tchooks: could not decode lastFire as FailedFire: %w", err)
+		}
+		return &fire, nil
+	case "no-fire":
+		var fire NoFire
+		if err := json.Unmarshal(hsr.LastFire, &fire); err != nil {
+			return nil, fmt.Errorf("tchooks: could not decode lastFire as NoFire: %w", err)
+		}
+		return &fire, nil
+	default:
+		return nil, fmt.Errorf("tchooks: unrecognized lastFire result %q", tag.Result)
+	}
+}
+
+// NewLastFire encodes v, one of *SuccessfulFire, *FailedFire, *NoFire (or
+// the non-pointer forms), into the json.RawMessage form used for
+// HookStatusResponse.LastFire, validating that its `result` tag is set to
+// the value the hooks service expects for that type.
+func NewLastFire(v interface{}) (json.RawMessage, error) {
+	var wantResult string
+	switch fire := v.(type) {
+	case SuccessfulFire:
+		wantResult = "success"
+		if fire.Result != wantResult {
+			return nil, fmt.Errorf("tchooks: SuccessfulFire.Result must be %q, got %q", wantResult, fire.Result)
+		}
+	case *SuccessfulFire:
+		return NewLastFire(*fire)
+	case FailedFire:
+		wantResult = "error"
+		if fire.Result != wantResult {
+			return nil, fmt.Errorf("tchooks: FailedFire.Result must be %q, got %q", wantResult, fire.Result)
+		}
+	case *FailedFire:
+		return NewLastFire(*fire)
+	case NoFire:
+		wantResult = "no-fire"
+		if fire.Result != wantResult {
+			return nil, fmt.Errorf("tchooks: NoFire.Result must be %q, got %q", wantResult, fire.Result)
+		}
+	case *NoFire:
+		return NewLastFire(*fire)
+	default:
+		return nil, fmt.Errorf("tchooks: %T is not a valid LastFire value", v)
+	}
+
+	return json.Marshal(v)
+}
+
+// DecodeResult decodes a TriggerHookResponse. It returns the task status and
+// true if the hook's template produced a task, or nil and false if the
+// response was the empty TriggerHookResponse1 object (no task created).
+func (thr *TriggerHookResponse) DecodeResult() (*TaskStatusStructure, bool, error) {
+	if len(*thr) == 0 || string(*thr) == "null" {
+		return nil, false, nil
+	}
+
+	var empty TriggerHookResponse1
+	if err := json.Unmarshal(*thr, &empty); err == nil {
+		var raw map[string]json.RawMessage
+		if err := json.Unmarshal(*thr, &raw); err == nil {
+			if _, hasStatus := raw["status"]; !hasStatus {
+				return nil, false, nil
+			}
+		}
+	}
+
+	var status TaskStatusStructure
+	if err := json.Unmarshal(*thr, &status); err != nil {
+		return nil, false, fmt.Errorf("tchooks: could not decode triggerHook response as a task status: %w", err)
+	}
+	return &status, true, nil
+}
@@ -0,0 +1,80 @@
+package tchooks
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestHookStatusResponseDecodeLastFireSuccess(t *testing.T) {
+	hsr := &HookStatusResponse{
+		LastFire: json.RawMessage(`{"result": "success", "taskId": "abc", "time": "2026-01-01T00:00:00.000Z"}`),
+	}
+	decoded, err := hsr.DecodeLastFire()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fire, ok := decoded.(*SuccessfulFire)
+	if !ok {
+		t.Fatalf("decoded type = %T, want *SuccessfulFire", decoded)
+	}
+	if fire.TaskID != "abc" {
+		t.Errorf("TaskID = %q, want %q", fire.TaskID, "abc")
+	}
+}
+
+func TestHookStatusResponseDecodeLastFireUnrecognized(t *testing.T) {
+	hsr := &HookStatusResponse{LastFire: json.RawMessage(`{"result": "bogus"}`)}
+	if _, err := hsr.DecodeLastFire(); err == nil {
+		t.Fatal("expected an error for an unrecognized lastFire result")
+	}
+}
+
+func TestHookStatusResponseDecodeLastFireAbsent(t *testing.T) {
+	hsr := &HookStatusResponse{}
+	if _, err := hsr.DecodeLastFire(); err == nil {
+		t.Fatal("expected an error when lastFire is not present")
+	}
+}
+
+func TestNewLastFireRoundTrips(t *testing.T) {
+	raw, err := NewLastFire(NoFire{Result: "no-fire"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hsr := &HookStatusResponse{LastFire: raw}
+	decoded, err := hsr.DecodeLastFire()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := decoded.(*NoFire); !ok {
+		t.Fatalf("decoded type = %T, want *NoFire", decoded)
+	}
+}
+
+func TestNewLastFireRejectsWrongTag(t *testing.T) {
+	if _, err := NewLastFire(NoFire{Result: "success"}); err == nil {
+		t.Fatal("expected an error when Result doesn't match the value's tag")
+	}
+}
+
+func TestTriggerHookResponseDecodeResultNoTask(t *testing.T) {
+	thr := TriggerHookResponse(`{}`)
+	status, created, err := thr.DecodeResult()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created || status != nil {
+		t.Errorf("got (%v, %v), want (nil, false)", status, created)
+	}
+}
+
+func TestTriggerHookResponseDecodeResultWithTask(t *testing.T) {
+	thr := TriggerHookResponse(`{"status": {"taskId": "abc"}}`)
+	status, created, err := thr.DecodeResult()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !created || status == nil || status.Status.TaskID != "abc" {
+		t.Errorf("got (%+v, %v), want a task status with taskId %q", status, created, "abc")
+	}
+}
@@ -0,0 +1,94 @@
+package tchooks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsone "github.com/taskcluster/json-e"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// queueTaskSchema is the subset of the Queue's task.json schema needed to
+// structurally validate a rendered task definition client-side. It is not a
+// full copy of that schema, just enough to catch the common template bugs
+// (missing required fields, wrong types) before a round trip to the hooks
+// service.
+const queueTaskSchema = `{
+  "type": "object",
+  "properties": {
+    "provisionerId": {"type": "string"},
+    "workerType": {"type": "string"},
+    "schedulerId": {"type": "string"},
+    "created": {"type": "string"},
+    "deadline": {"type": "string"},
+    "payload": {"type": "object"},
+    "metadata": {"type": "object"}
+  },
+  "required": ["provisionerId", "workerType", "created", "deadline", "payload", "metadata"]
+}`
+
+// defaultTriggerSchema is the schema the hooks service applies to
+// triggerHook payloads when a HookCreationRequest omits TriggerSchema, per
+// its documented default.
+const defaultTriggerSchema = `{"type": "object", "additionalProperties": false}`
+
+// RenderTask renders this HookCreationRequest's Task template with JSON-e
+// using the given context, exactly as the hooks service would when firing
+// the hook, and structurally validates the result against the Queue's
+// task.json schema. This lets callers catch template bugs locally instead
+// of discovering them via a production triggerHook call.
+func (hcr *HookCreationRequest) RenderTask(context map[string]interface{}) (json.RawMessage, error) {
+	var template interface{}
+	if err := json.Unmarshal(hcr.Task, &template); err != nil {
+		return nil, fmt.Errorf("tchooks: task template is not valid JSON: %w", err)
+	}
+
+	rendered, err := jsone.Render(template, context)
+	if err != nil {
+		return nil, fmt.Errorf("tchooks: rendering task template: %w", err)
+	}
+
+	task, err := json.Marshal(rendered)
+	if err != nil {
+		return nil, fmt.Errorf("tchooks: marshaling rendered task: %w", err)
+	}
+
+	if err := validateAgainstSchema(task, queueTaskSchema); err != nil {
+		return nil, fmt.Errorf("tchooks: rendered task does not match the queue's task schema: %w", err)
+	}
+
+	return task, nil
+}
+
+// ValidateTriggerPayload checks payload against this HookCreationRequest's
+// TriggerSchema, the same validation the hooks service performs on
+// triggerHook calls, so callers can catch invalid trigger payloads before
+// submitting them.
+func (hcr *HookCreationRequest) ValidateTriggerPayload(payload json.RawMessage) error {
+	schema := defaultTriggerSchema
+	if len(hcr.TriggerSchema) != 0 {
+		schema = string(hcr.TriggerSchema)
+	}
+	return validateAgainstSchema(payload, schema)
+}
+
+// validateAgainstSchema validates document against a JSON Schema, returning
+// a single error describing all violations if it does not conform.
+func validateAgainstSchema(document json.RawMessage, schema string) error {
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewStringLoader(schema),
+		gojsonschema.NewBytesLoader(document),
+	)
+	if err != nil {
+		return fmt.Errorf("evaluating schema: %w", err)
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	err = fmt.Errorf("%d schema violation(s)", len(result.Errors()))
+	for _, violation := range result.Errors() {
+		err = fmt.Errorf("%w; %s", err, violation)
+	}
+	return err
+}
@@ -0,0 +1,61 @@
+package tchooks
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestHookCreationRequestRenderTask(t *testing.T) {
+	hcr := &HookCreationRequest{
+		Task: json.RawMessage(`{
+			"provisionerId": "prov",
+			"workerType": "wt",
+			"created": {"$fromNow": "0 seconds"},
+			"deadline": {"$fromNow": "1 hour"},
+			"payload": {"image": "${image}"},
+			"metadata": {"name": "x", "description": "x", "owner": "x@example.com", "source": "x"}
+		}`),
+	}
+	rendered, err := hcr.RenderTask(map[string]interface{}{"image": "ubuntu"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var task map[string]interface{}
+	if err := json.Unmarshal(rendered, &task); err != nil {
+		t.Fatalf("rendered output is not valid JSON: %v", err)
+	}
+	if task["payload"].(map[string]interface{})["image"] != "ubuntu" {
+		t.Errorf("payload.image = %v, want %q", task["payload"], "ubuntu")
+	}
+}
+
+func TestHookCreationRequestRenderTaskMissingRequiredField(t *testing.T) {
+	hcr := &HookCreationRequest{
+		Task: json.RawMessage(`{"provisionerId": "prov", "workerType": "wt", "payload": {}, "metadata": {}}`),
+	}
+	if _, err := hcr.RenderTask(map[string]interface{}{}); err == nil {
+		t.Fatal("expected an error for a rendered task missing required fields like created/deadline")
+	}
+}
+
+func TestValidateTriggerPayloadDefaultSchema(t *testing.T) {
+	hcr := &HookCreationRequest{}
+	if err := hcr.ValidateTriggerPayload(json.RawMessage(`{}`)); err != nil {
+		t.Errorf("unexpected error for an empty object against the default schema: %v", err)
+	}
+	if err := hcr.ValidateTriggerPayload(json.RawMessage(`{"extra": true}`)); err == nil {
+		t.Error("expected an error: default trigger schema forbids additional properties")
+	}
+}
+
+func TestValidateTriggerPayloadCustomSchema(t *testing.T) {
+	hcr := &HookCreationRequest{
+		TriggerSchema: json.RawMessage(`{"type": "object", "required": ["branch"]}`),
+	}
+	if err := hcr.ValidateTriggerPayload(json.RawMessage(`{"branch": "main"}`)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := hcr.ValidateTriggerPayload(json.RawMessage(`{}`)); err == nil {
+		t.Error("expected an error: payload is missing the required \"branch\" property")
+	}
+}
@@ -0,0 +1,103 @@
+package tchooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CronType classifies a cron expression for display purposes, e.g. so a UI
+// can show "Daily at 03:00 UTC" instead of `0 3 * * *`.
+type CronType string
+
+// Recognized values for ScheduleEntry.CronType.
+const (
+	CronTypeHourly  CronType = "hourly"
+	CronTypeDaily   CronType = "daily"
+	CronTypeWeekly  CronType = "weekly"
+	CronTypeMonthly CronType = "monthly"
+	CronTypeCustom  CronType = "custom"
+)
+
+// UnmarshalJSON accepts either a plain cron string (the pre-existing wire
+// format) or a ScheduleEntry object, so that old clients and old stored
+// hook definitions keep working.
+func (se *ScheduleEntry) UnmarshalJSON(data []byte) error {
+	var cron string
+	if err := json.Unmarshal(data, &cron); err == nil {
+		se.Cron = cron
+		se.CronType = ClassifyCron(cron)
+		se.Timezone = ""
+		se.Description = ""
+		return nil
+	}
+
+	type scheduleEntryAlias ScheduleEntry
+	var alias scheduleEntryAlias
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return fmt.Errorf("tchooks: schedule entry must be a cron string or object: %w", err)
+	}
+	if alias.CronType == "" {
+		alias.CronType = ClassifyCron(alias.Cron)
+	}
+	*se = ScheduleEntry(alias)
+	return nil
+}
+
+// MarshalJSON encodes se as a plain cron string when it carries no metadata
+// beyond what ClassifyCron would derive, keeping the wire format unchanged
+// for the common case so existing callers of createHook/updateHook (and the
+// hooks service's `schedule` schema, which is still a plain string array)
+// are unaffected. Entries with a Description, a non-UTC Timezone, or a
+// CronType other than the derived one are encoded as an object, since that
+// information would otherwise be lost.
+func (se ScheduleEntry) MarshalJSON() ([]byte, error) {
+	if se.Description == "" && (se.Timezone == "" || se.Timezone == "UTC") &&
+		(se.CronType == "" || se.CronType == ClassifyCron(se.Cron)) {
+		return json.Marshal(se.Cron)
+	}
+
+	type scheduleEntryAlias ScheduleEntry
+	return json.Marshal(scheduleEntryAlias(se))
+}
+
+// ParseSchedule inspects a raw schedule as received from the hooks service
+// (a list of plain cron strings) and returns the equivalent ScheduleEntry
+// values with CronType derived via ClassifyCron.
+func ParseSchedule(cronExpressions []string) []ScheduleEntry {
+	entries := make([]ScheduleEntry, len(cronExpressions))
+	for i, cron := range cronExpressions {
+		entries[i] = ScheduleEntry{
+			Cron:     cron,
+			CronType: ClassifyCron(cron),
+		}
+	}
+	return entries
+}
+
+// ClassifyCron inspects a 5-field cron expression (minute hour day-of-month
+// month day-of-week) and returns the CronType a UI should use to describe
+// it, e.g. "Daily at 03:00 UTC" instead of the raw expression.
+func ClassifyCron(cron string) CronType {
+	fields := strings.Fields(cron)
+	if len(fields) != 5 {
+		return CronTypeCustom
+	}
+	minute, hour, dom, month, dow := fields[0], fields[1], fields[2], fields[3], fields[4]
+
+	wildcard := func(field string) bool { return field == "*" }
+	fixed := func(field string) bool { return !wildcard(field) && !strings.ContainsAny(field, "*/,-") }
+
+	switch {
+	case fixed(minute) && wildcard(hour) && wildcard(dom) && wildcard(month) && wildcard(dow):
+		return CronTypeHourly
+	case fixed(minute) && fixed(hour) && wildcard(dom) && wildcard(month) && wildcard(dow):
+		return CronTypeDaily
+	case fixed(minute) && fixed(hour) && wildcard(dom) && wildcard(month) && !wildcard(dow):
+		return CronTypeWeekly
+	case fixed(minute) && fixed(hour) && !wildcard(dom) && wildcard(month) && wildcard(dow):
+		return CronTypeMonthly
+	default:
+		return CronTypeCustom
+	}
+}
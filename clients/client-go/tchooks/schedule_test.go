@@ -0,0 +1,75 @@
+package tchooks
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestClassifyCron(t *testing.T) {
+	cases := map[string]CronType{
+		"0 * * * *":   CronTypeHourly,
+		"0 3 * * *":   CronTypeDaily,
+		"0 3 * * 1":   CronTypeWeekly,
+		"0 3 1 * *":   CronTypeMonthly,
+		"*/5 * * * *": CronTypeCustom,
+		"not a cron":  CronTypeCustom,
+	}
+	for cron, want := range cases {
+		if got := ClassifyCron(cron); got != want {
+			t.Errorf("ClassifyCron(%q) = %q, want %q", cron, got, want)
+		}
+	}
+}
+
+func TestScheduleEntryUnmarshalJSONPlainString(t *testing.T) {
+	var se ScheduleEntry
+	if err := json.Unmarshal([]byte(`"0 3 * * *"`), &se); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if se.Cron != "0 3 * * *" {
+		t.Errorf("Cron = %q, want %q", se.Cron, "0 3 * * *")
+	}
+	if se.CronType != CronTypeDaily {
+		t.Errorf("CronType = %q, want %q", se.CronType, CronTypeDaily)
+	}
+}
+
+func TestScheduleEntryUnmarshalJSONObject(t *testing.T) {
+	var se ScheduleEntry
+	input := `{"cron": "0 3 * * *", "timezone": "America/New_York", "description": "nightly build"}`
+	if err := json.Unmarshal([]byte(input), &se); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if se.Timezone != "America/New_York" || se.Description != "nightly build" {
+		t.Errorf("unexpected ScheduleEntry: %+v", se)
+	}
+	if se.CronType != CronTypeDaily {
+		t.Errorf("CronType = %q, want %q (should be derived when omitted)", se.CronType, CronTypeDaily)
+	}
+}
+
+func TestScheduleEntryMarshalJSONRoundTripsPlainString(t *testing.T) {
+	se := ScheduleEntry{Cron: "0 3 * * *", CronType: CronTypeDaily}
+	data, err := json.Marshal(se)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `"0 3 * * *"` {
+		t.Errorf("MarshalJSON = %s, want a plain cron string so existing createHook callers see no wire format change", data)
+	}
+}
+
+func TestScheduleEntryMarshalJSONObjectWhenDescribed(t *testing.T) {
+	se := ScheduleEntry{Cron: "0 3 * * *", CronType: CronTypeDaily, Description: "nightly build"}
+	data, err := json.Marshal(se)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("expected an object when Description is set, got %s", data)
+	}
+	if decoded["description"] != "nightly build" {
+		t.Errorf("decoded[\"description\"] = %v, want %q", decoded["description"], "nightly build")
+	}
+}
@@ -0,0 +1,63 @@
+package tchooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// TriggerHandler lets a hook be fired by sources other than Schedule and
+// Bindings (e.g. webhooks or queue task events) without a service-side
+// schema release. Each kind of trigger registers a TriggerHandler under the
+// name used in TriggerConfig.Kind.
+type TriggerHandler interface {
+	// ValidateConfig checks a TriggerConfig.Config blob for this handler
+	// before it is stored on a hook.
+	ValidateConfig(config json.RawMessage) error
+
+	// Context builds the JSON-e rendering context for a firing of this
+	// trigger, given its stored config and the event-specific payload (e.g.
+	// the webhook body or the queue task-event message).
+	Context(config json.RawMessage, payload json.RawMessage) (interface{}, error)
+}
+
+var (
+	triggerHandlersMu sync.RWMutex
+	triggerHandlers   = map[string]TriggerHandler{}
+)
+
+// RegisterTriggerHandler makes a TriggerHandler available under kind, for
+// use in TriggerConfig.Kind. It panics if kind is already registered, the
+// same way database/sql's driver registry does for duplicate drivers.
+func RegisterTriggerHandler(kind string, handler TriggerHandler) {
+	triggerHandlersMu.Lock()
+	defer triggerHandlersMu.Unlock()
+	if _, ok := triggerHandlers[kind]; ok {
+		panic(fmt.Sprintf("tchooks: RegisterTriggerHandler called twice for kind %q", kind))
+	}
+	triggerHandlers[kind] = handler
+}
+
+// TriggerHandlerFor looks up the TriggerHandler registered for kind. The ok
+// result is false if no handler has been registered under that name.
+func TriggerHandlerFor(kind string) (handler TriggerHandler, ok bool) {
+	triggerHandlersMu.RLock()
+	defer triggerHandlersMu.RUnlock()
+	handler, ok = triggerHandlers[kind]
+	return
+}
+
+// ValidateTriggers checks that every entry in triggers names a registered
+// TriggerHandler and passes that handler's own config validation.
+func ValidateTriggers(triggers []TriggerConfig) error {
+	for _, trigger := range triggers {
+		handler, ok := TriggerHandlerFor(trigger.Kind)
+		if !ok {
+			return fmt.Errorf("tchooks: no TriggerHandler registered for kind %q", trigger.Kind)
+		}
+		if err := handler.ValidateConfig(trigger.Config); err != nil {
+			return fmt.Errorf("tchooks: invalid config for trigger kind %q: %w", trigger.Kind, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package tchooks
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// fakeTriggerHandler is a minimal TriggerHandler for tests: it rejects any
+// config equal to `{"reject":true}`, and builds a render context holding the
+// config and the payload.
+type fakeTriggerHandler struct{}
+
+func (fakeTriggerHandler) ValidateConfig(config json.RawMessage) error {
+	if string(config) == `{"reject":true}` {
+		return errors.New("rejected by fakeTriggerHandler")
+	}
+	return nil
+}
+
+func (fakeTriggerHandler) Context(config json.RawMessage, payload json.RawMessage) (interface{}, error) {
+	return map[string]interface{}{"config": config, "payload": payload}, nil
+}
+
+func TestRegisterAndLookupTriggerHandler(t *testing.T) {
+	RegisterTriggerHandler("test-register-lookup", fakeTriggerHandler{})
+
+	handler, ok := TriggerHandlerFor("test-register-lookup")
+	if !ok {
+		t.Fatal("TriggerHandlerFor did not find the registered handler")
+	}
+	if _, isFake := handler.(fakeTriggerHandler); !isFake {
+		t.Errorf("TriggerHandlerFor returned %T, want fakeTriggerHandler", handler)
+	}
+}
+
+func TestRegisterTriggerHandlerDuplicatePanics(t *testing.T) {
+	RegisterTriggerHandler("test-duplicate", fakeTriggerHandler{})
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected RegisterTriggerHandler to panic on a duplicate kind")
+		}
+	}()
+	RegisterTriggerHandler("test-duplicate", fakeTriggerHandler{})
+}
+
+func TestTriggerHandlerForMiss(t *testing.T) {
+	if _, ok := TriggerHandlerFor("test-kind-never-registered"); ok {
+		t.Error("TriggerHandlerFor found a handler for a kind that was never registered")
+	}
+}
+
+func TestValidateTriggersSuccess(t *testing.T) {
+	RegisterTriggerHandler("test-validate-success", fakeTriggerHandler{})
+
+	triggers := []TriggerConfig{
+		{Kind: "test-validate-success", Config: json.RawMessage(`{"reject":false}`)},
+	}
+	if err := ValidateTriggers(triggers); err != nil {
+		t.Errorf("ValidateTriggers() = %v, want nil", err)
+	}
+}
+
+func TestValidateTriggersUnregisteredKind(t *testing.T) {
+	triggers := []TriggerConfig{
+		{Kind: "test-validate-unregistered", Config: json.RawMessage(`{}`)},
+	}
+	if err := ValidateTriggers(triggers); err == nil {
+		t.Error("ValidateTriggers() = nil, want an error for an unregistered kind")
+	}
+}
+
+func TestValidateTriggersRejectedConfig(t *testing.T) {
+	RegisterTriggerHandler("test-validate-rejected", fakeTriggerHandler{})
+
+	triggers := []TriggerConfig{
+		{Kind: "test-validate-rejected", Config: json.RawMessage(`{"reject":true}`)},
+	}
+	if err := ValidateTriggers(triggers); err == nil {
+		t.Error("ValidateTriggers() = nil, want an error when the handler rejects the config")
+	}
+}
@@ -41,6 +41,23 @@ type (
 	HookCreationRequest struct {
 		Bindings []Binding `json:"bindings,omitempty"`
 
+		// Specifies how to treat an already-running task created by this hook when
+		// the next scheduled or triggered fire occurs.
+		//
+		// Possible values:
+		//   * "Allow"
+		//   * "Forbid"
+		//   * "Replace"
+		//
+		// Default:    "Allow"
+		ConcurrencyPolicy string `json:"concurrencyPolicy,omitempty"`
+
+		// Number of failed fires to keep a record of. Older runs beyond this
+		// limit are discarded.
+		//
+		// Default:    10
+		FailedJobsHistoryLimit int64 `json:"failedJobsHistoryLimit,omitempty"`
+
 		// Syntax:     ^([a-zA-Z0-9-_]*)$
 		// Min length: 1
 		// Max length: 64
@@ -60,11 +77,22 @@ type (
 		// Default:    []
 		//
 		// Array items:
-		// Cron-like specification for when tasks should be created.  The pattern is
-		// parsed in a UTC context.
-		// See [cron-parser on npm](https://www.npmjs.com/package/cron-parser).
+		// Cron-like specification for when tasks should be created, along with
+		// human-readable metadata about the schedule. Accepts either a plain
+		// cron string or a ScheduleEntry object for backward compatibility.
 		// Note that tasks may not be created at exactly the time specified.
-		Schedule []string `json:"schedule,omitempty"`
+		Schedule []ScheduleEntry `json:"schedule,omitempty"`
+
+		// Deadline, in seconds, after a scheduled/triggered fire was due at which
+		// it is no longer worth creating the task (e.g., the hooks service was
+		// down). If omitted, there is no deadline.
+		StartingDeadlineSeconds int64 `json:"startingDeadlineSeconds,omitempty"`
+
+		// Number of successful fires to keep a record of. Older runs beyond this
+		// limit are discarded.
+		//
+		// Default:    10
+		SuccessfulJobsHistoryLimit int64 `json:"successfulJobsHistoryLimit,omitempty"`
 
 		// Template for the task definition.  This is rendered using [JSON-e](https://taskcluster.github.io/json-e/)
 		// as described in [firing hooks](/docs/reference/core/hooks/firing-hooks) to produce
@@ -80,12 +108,32 @@ type (
 		//
 		// Additional properties allowed
 		TriggerSchema json.RawMessage `json:"triggerSchema,omitempty"`
+
+		// Additional ways, beyond Schedule and Bindings, that this hook can be
+		// fired, e.g. webhooks or queue task events. Coexists with
+		// Schedule/Bindings for backward compatibility.
+		//
+		// Default:    []
+		Triggers []TriggerConfig `json:"triggers,omitempty"`
 	}
 
 	// Definition of a hook that will create tasks when defined events occur.
 	HookDefinition struct {
 		Bindings []Binding `json:"bindings,omitempty"`
 
+		// Specifies how to treat an already-running task created by this hook when
+		// the next scheduled or triggered fire occurs.
+		//
+		// Possible values:
+		//   * "Allow"
+		//   * "Forbid"
+		//   * "Replace"
+		ConcurrencyPolicy string `json:"concurrencyPolicy"`
+
+		// Number of failed fires to keep a record of. Older runs beyond this
+		// limit are discarded.
+		FailedJobsHistoryLimit int64 `json:"failedJobsHistoryLimit"`
+
 		// Syntax:     ^([a-zA-Z0-9-_]*)$
 		// Min length: 1
 		// Max length: 64
@@ -106,10 +154,18 @@ type (
 		// Default:    []
 		//
 		// Array items:
-		// Cron-like specification for when tasks should be created.  The pattern is
-		// parsed in a UTC context.
-		// See [cron-parser on npm](https://www.npmjs.com/package/cron-parser).
-		Schedule []string `json:"schedule"`
+		// Cron-like specification for when tasks should be created, along with
+		// human-readable metadata about the schedule.
+		Schedule []ScheduleEntry `json:"schedule"`
+
+		// Deadline, in seconds, after a scheduled/triggered fire was due at which
+		// it is no longer worth creating the task (e.g., the hooks service was
+		// down). If omitted, there is no deadline.
+		StartingDeadlineSeconds int64 `json:"startingDeadlineSeconds,omitempty"`
+
+		// Number of successful fires to keep a record of. Older runs beyond this
+		// limit are discarded.
+		SuccessfulJobsHistoryLimit int64 `json:"successfulJobsHistoryLimit"`
 
 		// Template for the task definition.  This is rendered using [JSON-e](https://taskcluster.github.io/json-e/)
 		// as described in [firing hooks](/docs/reference/core/hooks/firing-hooks) to produce
@@ -120,6 +176,11 @@ type (
 
 		// Additional properties allowed
 		TriggerSchema json.RawMessage `json:"triggerSchema"`
+
+		// Additional ways, beyond Schedule and Bindings, that this hook can be
+		// fired, e.g. webhooks or queue task events. Coexists with
+		// Schedule/Bindings for backward compatibility.
+		Triggers []TriggerConfig `json:"triggers"`
 	}
 
 	// List of `hookGroupIds`.
@@ -157,9 +218,124 @@ type (
 		Owner string `json:"owner"`
 	}
 
+	// Detail of a single firing of a hook, as recorded in its run history.
+	HookRun struct {
+
+		// The error that occurred when firing the task, if Result is "error".
+		//
+		// Additional properties allowed
+		Error json.RawMessage `json:"error,omitempty"`
+
+		// The stack trace of the error that occurred rendering or submitting
+		// the task, if any, to help operators debug failed fires without
+		// cross-referencing pulse logs.
+		ErrorStack string `json:"errorStack,omitempty"`
+
+		// What caused this run, with the same values as Var.FiredBy.
+		//
+		// Possible values:
+		//   * "schedule"
+		//   * "triggerHook"
+		//   * "triggerHookWithToken"
+		//   * "pulseMessage"
+		FiredBy string `json:"firedBy"`
+
+		// Syntax:     ^([a-zA-Z0-9-_]*)$
+		// Min length: 1
+		// Max length: 64
+		HookGroupID string `json:"hookGroupId"`
+
+		// Syntax:     ^([a-zA-Z0-9-_/]*)$
+		// Min length: 1
+		// Max length: 64
+		HookID string `json:"hookId"`
+
+		// SHA-256 hash of the JSON-e context the task template was rendered
+		// with, so operators can compare fires without storing the full
+		// (possibly sensitive) context.
+		InputContextHash string `json:"inputContextHash,omitempty"`
+
+		// SHA-256 hash of the rendered task definition, before submission to
+		// the Queue.
+		RenderedTaskHash string `json:"renderedTaskHash,omitempty"`
+
+		// Information about success or failure of firing of the hook
+		//
+		// Possible values:
+		//   * "success"
+		//   * "error"
+		Result string `json:"result"`
+
+		// Time when the task was created
+		TaskCreateTime tcclient.Time `json:"taskCreateTime"`
+
+		// Unique task identifier, this is UUID encoded as
+		// [URL-safe base64](http://tools.ietf.org/html/rfc4648#section-5) and
+		// stripped of `=` padding. Empty if no task was created.
+		//
+		// Syntax:     ^[A-Za-z0-9_-]{8}[Q-T][A-Za-z0-9_-][CGKOSWaeimquy26-][A-Za-z0-9_-]{10}[AQgw]$
+		TaskID string `json:"taskId,omitempty"`
+	}
+
+	// Filters accepted by ListHookRuns. All fields are optional; an unset
+	// field places no restriction on the results. HookGroupID and HookID
+	// are not sent by Values; ListHookRuns takes the hook from its own
+	// arguments.
+	HookRunsQuery struct {
+
+		// Continuation token from a previous HookRunsPage, for fetching the
+		// next page of results.
+		ContinuationToken string `json:"continuationToken,omitempty"`
+
+		// Only include runs fired by this identity, matching HookRun.FiredBy.
+		FiredBy string `json:"firedBy,omitempty"`
+
+		// Syntax:     ^([a-zA-Z0-9-_]*)$
+		HookGroupID string `json:"hookGroupId,omitempty"`
+
+		// Syntax:     ^([a-zA-Z0-9-_/]*)$
+		HookID string `json:"hookId,omitempty"`
+
+		// Maximum number of runs to return in one page.
+		//
+		// Default:    1000
+		Limit int64 `json:"limit,omitempty"`
+
+		// Only include runs with this result.
+		//
+		// Possible values:
+		//   * "success"
+		//   * "error"
+		Result string `json:"result,omitempty"`
+
+		// Only include runs fired at or after this time.
+		Since tcclient.Time `json:"since,omitempty"`
+
+		// Only include runs fired at or before this time.
+		Until tcclient.Time `json:"until,omitempty"`
+	}
+
+	// A page of results from ListHookRuns.
+	HookRunsPage struct {
+
+		// Opaque token to pass as HookRunsQuery.ContinuationToken to fetch the
+		// next page. Omitted when there are no more results.
+		ContinuationToken string `json:"continuationToken,omitempty"`
+
+		Runs []HookRun `json:"runs"`
+	}
+
 	// A snapshot of the current status of a hook.
 	HookStatusResponse struct {
 
+		// Task IDs of tasks created by this hook that are still pending or
+		// running. This lets callers reason about the hook's concurrency
+		// without polling the queue. Always present, but may be empty.
+		//
+		// Array items:
+		// Syntax:     ^[A-Za-z0-9_-]{8}[Q-T][A-Za-z0-9_-][CGKOSWaeimquy26-][A-Za-z0-9_-]{10}[AQgw]$
+		CurrentRuns []string `json:"currentRuns"`
+
 		// Information about the last time this hook fired.  This property is only present
 		// if the hook has fired at least once.
 		//
@@ -275,6 +451,40 @@ type (
 		WorkerID string `json:"workerId,omitempty"`
 	}
 
+	// A single entry in a hook's schedule: a cron expression along with
+	// human-readable metadata describing it. Accepts either a plain cron
+	// string or this object when unmarshaled from JSON.
+	ScheduleEntry struct {
+
+		// Cron-like specification for when tasks should be created.  The pattern is
+		// parsed in a UTC context, regardless of Timezone.
+		// See [cron-parser on npm](https://www.npmjs.com/package/cron-parser).
+		Cron string `json:"cron"`
+
+		// The kind of cron expression this is, for UIs that want to display
+		// "Daily at 03:00 UTC" instead of `0 3 * * *`. Derived by ClassifyCron
+		// if not given explicitly.
+		//
+		// Possible values:
+		//   * "hourly"
+		//   * "daily"
+		//   * "weekly"
+		//   * "monthly"
+		//   * "custom"
+		CronType CronType `json:"cronType,omitempty"`
+
+		// Human-readable description of what this schedule entry is for.
+		Description string `json:"description,omitempty"`
+
+		// IANA timezone name that Cron is intended to be interpreted in,
+		// e.g. "America/New_York", for display purposes. This is metadata
+		// only: Cron itself must still be specified in UTC, since that is
+		// the only timezone the hooks service's scheduler understands.
+		//
+		// Default:    "UTC"
+		Timezone string `json:"timezone,omitempty"`
+	}
+
 	Status struct {
 
 		// Deadline of the task, `pending` and `running` runs are
@@ -366,6 +576,25 @@ type (
 		Status Status `json:"status"`
 	}
 
+	// A single entry in HookCreationRequest.Triggers/HookDefinition.Triggers,
+	// identifying a TriggerHandler by Kind and carrying its handler-specific
+	// configuration.
+	TriggerConfig struct {
+
+		// Handler-specific configuration, e.g. a webhook's allowed source IPs
+		// or a queue-task-event trigger's taskId/event filter. Validated by
+		// the TriggerHandler registered for Kind.
+		//
+		// Additional properties allowed
+		Config json.RawMessage `json:"config"`
+
+		// Name under which a TriggerHandler was registered with
+		// RegisterTriggerHandler, e.g. "webhook" or "taskCompleted".
+		//
+		// Min length: 1
+		Kind string `json:"kind"`
+	}
+
 	// A request to trigger a hook.  The payload must be a JSON object, and is used as the context
 	// for a JSON-e rendering of the hook's task template, as described in "Firing Hooks".
 	//